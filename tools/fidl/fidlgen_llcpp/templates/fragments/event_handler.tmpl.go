@@ -0,0 +1,148 @@
+// Copyright 2020 The Fuchsia Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package fragments
+
+// TODO(fxbug.dev): the EventHandler/WireEventDispatcher golden output is not
+// yet covered by this generator's codegen golden tests; add coverage before
+// this lands.
+const EventHandler = `
+{{- define "EventHandlerIndividualMethodSignature" }}
+virtual void On{{ .Name }}({{ template "Params" .Response }}) { Unknown(); }
+{{- end }}
+
+{{- define "EventCallbackArgs" }}
+  {{- range $i, $p := . }}{{ if $i }}, {{ end }}event->{{ $p.Name }}{{- end }}
+{{- end }}
+
+{{- define "EventHandlerDeclaration" }}
+// Interface to be implemented by consumers of {{ .Name }} who wish to
+// handle incoming events. Each per-event method is virtual with a default
+// body that calls Unknown(), so overriding only the events of interest is
+// sufficient.
+class {{ .Name }}::EventHandler {
+ public:
+  EventHandler() = default;
+  virtual ~EventHandler() = default;
+
+  {{- range FilterMethodsWithReqs .Methods }}
+{{ "" }}
+  {{ template "EventHandlerIndividualMethodSignature" . }}
+  {{- end }}
+
+  // Handler for any events that were not recognized by this instance, e.g.
+  // because they were added in a later FIDL library revision. Also called by
+  // the default body of every per-event method above that is not overridden.
+  virtual void Unknown() {}
+};
+{{- end }}
+
+{{- define "WireAsyncEventHandlerDeclaration" }}
+{{ EnsureNamespace "" }}
+template <>
+class fidl::WireAsyncEventHandler<{{ .Namespace }}::{{ .Name }}>
+    : public {{ .Namespace }}::{{ .Name }}::EventHandler,
+      public ::fidl::internal::AsyncEventHandler {
+ public:
+  WireAsyncEventHandler() = default;
+};
+{{- end }}
+
+{{- define "WireSyncEventHandlerDeclaration" }}
+{{ EnsureNamespace "" }}
+template <>
+class fidl::WireSyncEventHandler<{{ .Namespace }}::{{ .Name }}>
+    : public {{ .Namespace }}::{{ .Name }}::EventHandler {
+ public:
+  WireSyncEventHandler() = default;
+
+  // Handle all possible events defined in this protocol.
+  // Blocks to consume exactly one message from the channel, then call the
+  // corresponding virtual method.
+  ::fidl::Result HandleOneEvent(::zx::unowned_channel channel);
+};
+{{- end }}
+
+{{- define "WireEventDispatcherDeclaration" }}
+{{ EnsureNamespace "" }}
+template <>
+class fidl::internal::WireEventDispatcher<{{ .Namespace }}::{{ .Name }}> final
+    : public ::fidl::internal::IncomingEventDispatcher<{{ .Namespace }}::{{ .Name }}::EventHandler> {
+ public:
+  using IncomingEventDispatcher::IncomingEventDispatcher;
+
+ private:
+  std::optional<::fidl::UnbindInfo> DispatchEvent(::fidl::IncomingMessage& msg) override;
+};
+{{- end }}
+
+{{- define "WireEventDispatcherDefinition" }}
+std::optional<::fidl::UnbindInfo> fidl::internal::WireEventDispatcher<{{ .Namespace }}::{{ .Name }}>::DispatchEvent(
+    ::fidl::IncomingMessage& msg) {
+  fidl_message_header_t* hdr = msg.header();
+  switch (hdr->ordinal) {
+    {{- range FilterMethodsWithReqs .Methods }}
+    case {{ .OrdinalName }}: {
+      ::fidl::DecodedMessage<{{ $.Name }}::{{ .Name }}Response> decoded(std::move(msg));
+      if (!decoded.ok()) {
+        return ::fidl::UnbindInfo{::fidl::UnbindInfo::kDecodeError, decoded.status()};
+      }
+      auto* event = decoded.PrimaryObject();
+      event_handler()->On{{ .Name }}({{ template "EventCallbackArgs" .Response }});
+      return {};
+    }
+    {{- end }}
+    default: {
+      event_handler()->Unknown();
+      return {};
+    }
+  }
+}
+{{- end }}
+
+{{- define "WireSyncEventHandlerDefinition" }}
+::fidl::Result fidl::WireSyncEventHandler<{{ .Namespace }}::{{ .Name }}>::HandleOneEvent(
+    ::zx::unowned_channel channel) {
+  zx_status_t status =
+      channel->wait_one(ZX_CHANNEL_READABLE | ZX_CHANNEL_PEER_CLOSED, ::zx::time::infinite(), nullptr);
+  if (status != ZX_OK) {
+    return ::fidl::Result(status, ::fidl::kErrorWaitOnChannel);
+  }
+
+  FIDL_ALIGNDECL uint8_t bytes[ZX_CHANNEL_MAX_MSG_BYTES];
+  zx_handle_t handles[ZX_CHANNEL_MAX_MSG_HANDLES];
+  uint32_t num_bytes = 0u;
+  uint32_t num_handles = 0u;
+  status = channel->read(0, bytes, handles, ZX_CHANNEL_MAX_MSG_BYTES, ZX_CHANNEL_MAX_MSG_HANDLES,
+                          &num_bytes, &num_handles);
+  if (status != ZX_OK) {
+    return ::fidl::Result(status, ::fidl::kErrorReadingMsg);
+  }
+
+  ::fidl::IncomingMessage msg(bytes, num_bytes, handles, num_handles);
+  if (!msg.ok()) {
+    return ::fidl::Result(msg.status(), msg.error());
+  }
+
+  fidl_message_header_t* hdr = msg.header();
+  switch (hdr->ordinal) {
+    {{- range FilterMethodsWithReqs .Methods }}
+    case {{ .OrdinalName }}: {
+      ::fidl::DecodedMessage<{{ $.Name }}::{{ .Name }}Response> decoded(std::move(msg));
+      if (!decoded.ok()) {
+        return ::fidl::Result(decoded.status(), decoded.error());
+      }
+      auto* event = decoded.PrimaryObject();
+      this->On{{ .Name }}({{ template "EventCallbackArgs" .Response }});
+      return ::fidl::Result(ZX_OK, nullptr);
+    }
+    {{- end }}
+    default: {
+      this->Unknown();
+      return ::fidl::Result(ZX_OK, nullptr);
+    }
+  }
+}
+{{- end }}
+`